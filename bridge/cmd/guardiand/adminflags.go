@@ -0,0 +1,57 @@
+package guardiand
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/certusone/wormhole/bridge/pkg/common"
+	"github.com/certusone/wormhole/bridge/pkg/supervisor"
+	"github.com/certusone/wormhole/bridge/pkg/vaa"
+)
+
+var (
+	adminTCPListenAddr    = flag.String("adminTCPListenAddr", "", "Listen address for the admin gRPC TCP listener (mTLS + bearer token), disabled if unset")
+	adminTCPCertPath      = flag.String("adminTCPCertPath", "", "Path to the admin TCP listener's server certificate")
+	adminTCPKeyPath       = flag.String("adminTCPKeyPath", "", "Path to the admin TCP listener's server private key")
+	adminTCPClientCAPath  = flag.String("adminTCPClientCAPath", "", "Path to the CA bundle used to verify admin TCP client certificates")
+	adminTCPAuthTokenPath = flag.String("adminTCPAuthTokenPath", "", "Path to a file containing the bearer token required by the admin TCP listener")
+)
+
+// adminServiceRunnables assembles every admin-service Runnable that should be registered with the
+// supervisor: the UNIX socket listener (always), plus the mTLS + bearer-token TCP listener when
+// -adminTCPListenAddr is set, letting operators run the admin plane on a host separate from the
+// guardian p2p node.
+func adminServiceRunnables(logger *zap.Logger, socketPath string, injectC chan<- *vaa.VAA, signedVAAC chan<- *vaa.VAA, gst *common.GuardianSet) ([]supervisor.Runnable, error) {
+	socketRunnable, err := adminServiceRunnable(logger, socketPath, injectC, signedVAAC, gst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start admin UNIX socket listener: %w", err)
+	}
+
+	runnables := []supervisor.Runnable{socketRunnable}
+
+	if *adminTCPListenAddr == "" {
+		return runnables, nil
+	}
+
+	token, err := os.ReadFile(*adminTCPAuthTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read admin TCP auth token: %w", err)
+	}
+
+	tcpRunnable, err := adminTCPServiceRunnable(logger, &adminTCPServerConfig{
+		ListenAddr:   *adminTCPListenAddr,
+		CertFile:     *adminTCPCertPath,
+		KeyFile:      *adminTCPKeyPath,
+		ClientCAFile: *adminTCPClientCAPath,
+		AuthToken:    strings.TrimSpace(string(token)),
+	}, injectC, signedVAAC, gst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start admin TCP listener: %w", err)
+	}
+
+	return append(runnables, tcpRunnable), nil
+}
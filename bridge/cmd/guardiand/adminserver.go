@@ -2,16 +2,24 @@ package guardiand
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/certusone/wormhole/bridge/pkg/common"
@@ -24,6 +32,14 @@ type nodePrivilegedService struct {
 	nodev1.UnimplementedNodePrivilegedServer
 	injectC chan<- *vaa.VAA
 	logger  *zap.Logger
+
+	// signedVAAC publishes fully-signed VAAs assembled from an offline quorum
+	// of guardian signatures directly to the gossip network, bypassing the
+	// single-node observation/injection path that injectC feeds.
+	signedVAAC chan<- *vaa.VAA
+
+	// gst holds the guardian set that SubmitSignedVAA verifies signatures against.
+	gst *common.GuardianSet
 }
 
 // adminGuardianSetUpdateToVAA converts a nodev1.GuardianSetUpdate message to its canonical VAA representation.
@@ -59,6 +75,244 @@ func adminGuardianSetUpdateToVAA(req *nodev1.GuardianSetUpdate) (*vaa.VAA, error
 	return v, nil
 }
 
+// decodeHexAddress decodes a "0x"-prefixed or bare hex string into raw address bytes.
+func decodeHexAddress(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+// adminContractUpgradeToVAA converts a nodev1.ContractUpgrade message to its canonical VAA representation.
+// Returns an error if the data is invalid.
+func adminContractUpgradeToVAA(req *nodev1.ContractUpgrade) (*vaa.VAA, error) {
+	if req.TargetChainId == 0 || req.TargetChainId > 65535 {
+		return nil, fmt.Errorf("invalid target chain id: %d", req.TargetChainId)
+	}
+
+	rawAddr, err := decodeHexAddress(req.NewImplementationAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new implementation address: %w", err)
+	}
+
+	var addr vaa.Address
+	switch len(rawAddr) {
+	case 20:
+		// EVM addresses are left-padded to the 32-byte VAA address width.
+		copy(addr[12:], rawAddr)
+	case 32:
+		// Solana, Terra, and other non-EVM chains already use 32-byte addresses.
+		copy(addr[:], rawAddr)
+	default:
+		return nil, fmt.Errorf("new implementation address must be 20 or 32 bytes, got %d", len(rawAddr))
+	}
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: req.CurrentSetIndex,
+		Timestamp:        time.Unix(int64(req.Timestamp), 0),
+		Payload: &vaa.BodyContractUpgrade{
+			ChainID:     vaa.ChainID(req.TargetChainId),
+			NewContract: addr,
+			Nonce:       req.Nonce,
+		},
+	}
+
+	return v, nil
+}
+
+// adminRelayerConfigToVAA converts a nodev1.RelayerConfig message to its canonical VAA representation.
+// Returns an error if the data is invalid.
+func adminRelayerConfigToVAA(req *nodev1.RelayerConfig) (*vaa.VAA, error) {
+	if len(req.ChainConfigs) == 0 {
+		return nil, errors.New("empty relayer chain config specified")
+	}
+
+	if len(req.ChainConfigs) > vaa.MaxRelayerChainConfigs {
+		return nil, fmt.Errorf("too many chain configs - %d, maximum is %d", len(req.ChainConfigs), vaa.MaxRelayerChainConfigs)
+	}
+
+	chainConfigs := make([]vaa.RelayerChainConfig, len(req.ChainConfigs))
+	for i, c := range req.ChainConfigs {
+		if c.ChainId == 0 || c.ChainId > 65535 {
+			return nil, fmt.Errorf("invalid chain id at index %d (%d)", i, c.ChainId)
+		}
+
+		if !ethcommon.IsHexAddress(c.RelayerAddress) {
+			return nil, fmt.Errorf("invalid relayer address format at index %d", i)
+		}
+
+		chainConfigs[i] = vaa.RelayerChainConfig{
+			ChainID:        vaa.ChainID(c.ChainId),
+			RelayerAddress: ethcommon.HexToAddress(c.RelayerAddress),
+			GasLimit:       c.GasLimit,
+			DeliveryFee:    c.DeliveryFee,
+			Enabled:        c.Enabled,
+		}
+	}
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: req.CurrentSetIndex,
+		Timestamp:        time.Unix(int64(req.Timestamp), 0),
+		Payload: &vaa.BodyRelayerConfig{
+			Nonce:        req.Nonce,
+			ChainConfigs: chainConfigs,
+		},
+	}
+
+	return v, nil
+}
+
+// governancePayloadToVAA converts the oneof payload of a nodev1.PrepareUnsignedVAARequest to its
+// canonical unsigned VAA representation. Returns an error if the payload is invalid or unset.
+func governancePayloadToVAA(req *nodev1.PrepareUnsignedVAARequest) (*vaa.VAA, error) {
+	switch payload := req.Payload.(type) {
+	case *nodev1.PrepareUnsignedVAARequest_GuardianSet:
+		return adminGuardianSetUpdateToVAA(payload.GuardianSet)
+	case *nodev1.PrepareUnsignedVAARequest_ContractUpgrade:
+		return adminContractUpgradeToVAA(payload.ContractUpgrade)
+	case *nodev1.PrepareUnsignedVAARequest_RelayerConfig:
+		return adminRelayerConfigToVAA(payload.RelayerConfig)
+	default:
+		return nil, errors.New("no governance payload specified")
+	}
+}
+
+// PrepareUnsignedVAA constructs an unsigned governance VAA for the requested payload and returns
+// its digest and serialized form so it can be signed out-of-band by other guardians before being
+// submitted back via SubmitSignedVAA.
+func (s *nodePrivilegedService) PrepareUnsignedVAA(ctx context.Context, req *nodev1.PrepareUnsignedVAARequest) (*nodev1.PrepareUnsignedVAAResponse, error) {
+	v, err := governancePayloadToVAA(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	digest, err := v.SigningMsg()
+	if err != nil {
+		panic(err)
+	}
+
+	unsigned, err := v.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	s.logger.Info("unsigned governance VAA prepared for offline signing",
+		zap.Any("vaa", v),
+		zap.String("digest", digest.String()),
+	)
+
+	return &nodev1.PrepareUnsignedVAAResponse{
+		Digest:      digest.Bytes(),
+		UnsignedVaa: unsigned,
+	}, nil
+}
+
+// SubmitSignedVAA accepts a set of {guardianIndex, signature} tuples collected out-of-band,
+// verifies each one against the current guardian set, and once a quorum of valid signatures has
+// been assembled, publishes the fully-signed VAA to the network.
+func (s *nodePrivilegedService) SubmitSignedVAA(ctx context.Context, req *nodev1.SubmitSignedVAARequest) (*nodev1.SubmitSignedVAAResponse, error) {
+	if s.gst == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not yet known")
+	}
+
+	gs := s.gst
+	if len(gs.Keys) == 0 {
+		return nil, status.Error(codes.Unavailable, "guardian set is empty")
+	}
+
+	v, err := vaa.Unmarshal(req.UnsignedVaa)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal unsigned VAA: %v", err)
+	}
+
+	// unsigned_vaa is supposed to be exactly what PrepareUnsignedVAA handed out, with zero
+	// signatures. Discard any signatures embedded in it rather than trusting them, so a caller
+	// can't pad out the quorum count with fabricated entries and slip in just one real signature.
+	v.Signatures = nil
+
+	digest, err := v.SigningMsg()
+	if err != nil {
+		panic(err)
+	}
+
+	seen := make(map[uint8]bool)
+	for _, sig := range req.Signatures {
+		if sig.GuardianIndex >= uint32(len(gs.Keys)) {
+			return nil, status.Errorf(codes.InvalidArgument, "guardian index %d out of range for guardian set of size %d", sig.GuardianIndex, len(gs.Keys))
+		}
+
+		if len(sig.Signature) != 65 {
+			return nil, status.Errorf(codes.InvalidArgument, "signature from guardian %d is not 65 bytes", sig.GuardianIndex)
+		}
+
+		pubKey, err := ethcrypto.SigToPub(digest.Bytes(), sig.Signature)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to recover pubkey for guardian %d: %v", sig.GuardianIndex, err)
+		}
+
+		addr := ethcrypto.PubkeyToAddress(*pubKey)
+		if addr != gs.Keys[sig.GuardianIndex] {
+			return nil, status.Errorf(codes.InvalidArgument, "signature from guardian %d does not match guardian set", sig.GuardianIndex)
+		}
+
+		idx := uint8(sig.GuardianIndex)
+		if seen[idx] {
+			continue
+		}
+		seen[idx] = true
+
+		var sigData [65]byte
+		copy(sigData[:], sig.Signature)
+		v.Signatures = append(v.Signatures, &vaa.Signature{
+			Index:     idx,
+			Signature: sigData,
+		})
+	}
+
+	// grpc-go discards the response message whenever the handler also returns a non-nil error, so
+	// the partial-progress fields can't be returned alongside the FailedPrecondition status here.
+	quorum := vaa.CalculateQuorum(len(gs.Keys))
+	if len(v.Signatures) < quorum {
+		return nil, status.Errorf(codes.FailedPrecondition, "quorum not yet reached: got %d of %d required signatures", len(v.Signatures), quorum)
+	}
+
+	s.logger.Info("quorum reached for offline-signed governance VAA, publishing to network",
+		zap.Any("vaa", v),
+		zap.String("digest", digest.String()),
+		zap.Int("num_signatures", len(v.Signatures)),
+	)
+
+	s.signedVAAC <- v
+
+	return &nodev1.SubmitSignedVAAResponse{
+		QuorumReached: true,
+		NumSignatures: uint32(len(v.Signatures)),
+	}, nil
+}
+
+func (s *nodePrivilegedService) SubmitRelayerConfigVAA(ctx context.Context, req *nodev1.SubmitRelayerConfigVAARequest) (*nodev1.SubmitRelayerConfigVAAResponse, error) {
+	s.logger.Info("relayer config injected via admin socket", zap.String("request", req.String()))
+
+	v, err := adminRelayerConfigToVAA(req.RelayerConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Generate digest of the unsigned VAA.
+	digest, err := v.SigningMsg()
+	if err != nil {
+		panic(err)
+	}
+
+	s.logger.Info("relayer config VAA constructed",
+		zap.Any("vaa", v),
+		zap.String("digest", digest.String()),
+	)
+
+	s.injectC <- v
+
+	return &nodev1.SubmitRelayerConfigVAAResponse{Digest: digest.Bytes()}, nil
+}
+
 func (s *nodePrivilegedService) SubmitGuardianSetVAA(ctx context.Context, req *nodev1.SubmitGuardianSetVAARequest) (*nodev1.SubmitGuardianSetVAAResponse, error) {
 	s.logger.Info("guardian set injected via admin socket", zap.String("request", req.String()))
 
@@ -83,7 +337,31 @@ func (s *nodePrivilegedService) SubmitGuardianSetVAA(ctx context.Context, req *n
 	return &nodev1.SubmitGuardianSetVAAResponse{Digest: digest.Bytes()}, nil
 }
 
-func adminServiceRunnable(logger *zap.Logger, socketPath string, injectC chan<- *vaa.VAA) (supervisor.Runnable, error) {
+func (s *nodePrivilegedService) SubmitContractUpgradeVAA(ctx context.Context, req *nodev1.SubmitContractUpgradeVAARequest) (*nodev1.SubmitContractUpgradeVAAResponse, error) {
+	s.logger.Info("contract upgrade injected via admin socket", zap.String("request", req.String()))
+
+	v, err := adminContractUpgradeToVAA(req.ContractUpgrade)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Generate digest of the unsigned VAA.
+	digest, err := v.SigningMsg()
+	if err != nil {
+		panic(err)
+	}
+
+	s.logger.Info("contract upgrade VAA constructed",
+		zap.Any("vaa", v),
+		zap.String("digest", digest.String()),
+	)
+
+	s.injectC <- v
+
+	return &nodev1.SubmitContractUpgradeVAAResponse{Digest: digest.Bytes()}, nil
+}
+
+func adminServiceRunnable(logger *zap.Logger, socketPath string, injectC chan<- *vaa.VAA, signedVAAC chan<- *vaa.VAA, gst *common.GuardianSet) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
 	if err == nil {
@@ -112,12 +390,97 @@ func adminServiceRunnable(logger *zap.Logger, socketPath string, injectC chan<-
 
 	logger.Info("admin server listening on", zap.String("path", socketPath))
 
-	nodeService := &nodePrivilegedService{
-		injectC: injectC,
-		logger:  logger.Named("adminservice"),
-	}
+	nodeService := newNodePrivilegedService(logger, injectC, signedVAAC, gst)
 
 	grpcServer := grpc.NewServer()
 	nodev1.RegisterNodePrivilegedServer(grpcServer, nodeService)
 	return supervisor.GRPCServer(grpcServer, l, false), nil
 }
+
+// newNodePrivilegedService constructs the shared admin RPC handler used by both the UNIX socket
+// and the optional TCP listener.
+func newNodePrivilegedService(logger *zap.Logger, injectC chan<- *vaa.VAA, signedVAAC chan<- *vaa.VAA, gst *common.GuardianSet) *nodePrivilegedService {
+	return &nodePrivilegedService{
+		injectC:    injectC,
+		signedVAAC: signedVAAC,
+		gst:        gst,
+		logger:     logger.Named("adminservice"),
+	}
+}
+
+// adminTCPServerConfig configures the optional mTLS- and bearer-token-authenticated TCP listener
+// for the admin service. It lets operators run the admin plane (signing, governance) on a host
+// separate from the network-facing guardian daemon, mirroring how generic-relayer deployments
+// keep signer keys off the p2p node.
+type adminTCPServerConfig struct {
+	ListenAddr   string // e.g. "0.0.0.0:7070"
+	CertFile     string // server certificate presented to clients
+	KeyFile      string
+	ClientCAFile string // CA bundle used to verify client certificates (mTLS)
+	AuthToken    string // bearer token required on every call, on top of mTLS
+}
+
+// adminTCPServiceRunnable starts an admin gRPC server on a TCP listener secured with mutual TLS
+// client cert pinning and a per-call bearer token. Unlike adminServiceRunnable's UNIX socket,
+// this listener is reachable off-host, so both layers of authentication are mandatory.
+func adminTCPServiceRunnable(logger *zap.Logger, cfg *adminTCPServerConfig, injectC chan<- *vaa.VAA, signedVAAC chan<- *vaa.VAA, gst *common.GuardianSet) (supervisor.Runnable, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin server certificate: %w", err)
+	}
+
+	caCert, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read admin client CA file: %w", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to parse admin client CA certificate")
+	}
+
+	if cfg.AuthToken == "" {
+		return nil, errors.New("admin TCP listener requires a bearer auth token")
+	}
+
+	l, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", cfg.ListenAddr, err)
+	}
+
+	logger.Info("admin server listening on TCP with mTLS", zap.String("addr", cfg.ListenAddr))
+
+	nodeService := newNodePrivilegedService(logger, injectC, signedVAAC, gst)
+
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+	})
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(adminTokenAuthInterceptor(cfg.AuthToken)),
+	)
+	nodev1.RegisterNodePrivilegedServer(grpcServer, nodeService)
+	return supervisor.GRPCServer(grpcServer, l, false), nil
+}
+
+// adminTokenAuthInterceptor rejects any admin RPC that does not present the configured bearer
+// token in its "authorization" metadata. This is defense-in-depth on top of mTLS client
+// certificate pinning, not a replacement for it.
+func adminTokenAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing request metadata")
+		}
+
+		values := md.Get("authorization")
+		if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,200 @@
+package guardiand
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/certusone/wormhole/bridge/pkg/common"
+	nodev1 "github.com/certusone/wormhole/bridge/pkg/proto/node/v1"
+	"github.com/certusone/wormhole/bridge/pkg/vaa"
+)
+
+func TestAdminContractUpgradeToVAA(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *nodev1.ContractUpgrade
+		wantErr bool
+	}{
+		{
+			name: "valid EVM upgrade",
+			req: &nodev1.ContractUpgrade{
+				TargetChainId:            2,
+				NewImplementationAddress: "0x0000000000000000000000000000000000000001",
+				Nonce:                    1,
+				CurrentSetIndex:          0,
+				Timestamp:                1000,
+			},
+		},
+		{
+			name: "valid non-EVM (32-byte) upgrade",
+			req: &nodev1.ContractUpgrade{
+				TargetChainId:            1,
+				NewImplementationAddress: "0x0000000000000000000000000000000000000000000000000000000000000001",
+				Nonce:                    2,
+				CurrentSetIndex:          0,
+				Timestamp:                1000,
+			},
+		},
+		{
+			name: "invalid chain id",
+			req: &nodev1.ContractUpgrade{
+				TargetChainId:            0,
+				NewImplementationAddress: "0x01",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid address length",
+			req: &nodev1.ContractUpgrade{
+				TargetChainId:            2,
+				NewImplementationAddress: "0x0102",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := adminContractUpgradeToVAA(tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			digest, err := v.SigningMsg()
+			if err != nil {
+				t.Fatalf("SigningMsg failed: %v", err)
+			}
+
+			// Round-trip the unsigned VAA through Marshal/Unmarshal, as PrepareUnsignedVAA and
+			// SubmitSignedVAA do, and verify the digest is unchanged.
+			raw, err := v.Marshal()
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			roundTripped, err := vaa.Unmarshal(raw)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+
+			roundTrippedDigest, err := roundTripped.SigningMsg()
+			if err != nil {
+				t.Fatalf("SigningMsg on round-tripped VAA failed: %v", err)
+			}
+
+			if digest != roundTrippedDigest {
+				t.Fatalf("digest changed across round trip: %s != %s", digest, roundTrippedDigest)
+			}
+		})
+	}
+}
+
+// TestSubmitSignedVAAIgnoresEmbeddedSignatures verifies that SubmitSignedVAA does not let a
+// caller pad out the quorum count by submitting an unsigned_vaa that already carries fabricated
+// signatures - only signatures verified from req.Signatures may count towards quorum.
+func TestSubmitSignedVAAIgnoresEmbeddedSignatures(t *testing.T) {
+	gst := &common.GuardianSet{
+		Keys: []ethcommon.Address{
+			ethcommon.HexToAddress("0x0000000000000000000000000000000000000001"),
+			ethcommon.HexToAddress("0x0000000000000000000000000000000000000002"),
+			ethcommon.HexToAddress("0x0000000000000000000000000000000000000003"),
+			ethcommon.HexToAddress("0x0000000000000000000000000000000000000004"),
+		},
+	}
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        time.Unix(1000, 0),
+		Payload: &vaa.BodyContractUpgrade{
+			ChainID: vaa.ChainIDEthereum,
+			Nonce:   1,
+		},
+	}
+	// Fabricate enough embedded signatures to satisfy quorum on their own, without any of them
+	// being verified against gst.
+	for i := 0; i < vaa.CalculateQuorum(len(gst.Keys)); i++ {
+		v.Signatures = append(v.Signatures, &vaa.Signature{Index: uint8(i)})
+	}
+
+	raw, err := v.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	s := &nodePrivilegedService{
+		logger:     zap.NewNop(),
+		signedVAAC: make(chan *vaa.VAA, 1),
+		gst:        gst,
+	}
+
+	_, err = s.SubmitSignedVAA(context.Background(), &nodev1.SubmitSignedVAARequest{
+		UnsignedVaa: raw,
+	})
+	if err == nil {
+		t.Fatal("expected quorum-not-reached error, got nil - embedded signatures were not discarded")
+	}
+}
+
+func TestAdminRelayerConfigToVAATooManyChainConfigs(t *testing.T) {
+	chainConfigs := make([]*nodev1.RelayerChainConfig, vaa.MaxRelayerChainConfigs+1)
+	for i := range chainConfigs {
+		chainConfigs[i] = &nodev1.RelayerChainConfig{
+			ChainId:        2,
+			RelayerAddress: "0x0000000000000000000000000000000000000001",
+		}
+	}
+
+	_, err := adminRelayerConfigToVAA(&nodev1.RelayerConfig{ChainConfigs: chainConfigs})
+	if err == nil {
+		t.Fatal("expected error for too many chain configs, got nil")
+	}
+}
+
+func TestAdminServiceRunnables(t *testing.T) {
+	logger := zap.NewNop()
+	injectC := make(chan *vaa.VAA, 1)
+	signedVAAC := make(chan *vaa.VAA, 1)
+	gst := &common.GuardianSet{}
+
+	t.Run("TCP listener disabled", func(t *testing.T) {
+		socketPath := filepath.Join(t.TempDir(), "admin.sock")
+
+		runnables, err := adminServiceRunnables(logger, socketPath, injectC, signedVAAC, gst)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(runnables) != 1 {
+			t.Fatalf("expected 1 runnable with the TCP listener disabled, got %d", len(runnables))
+		}
+	})
+
+	t.Run("TCP listener enabled but auth token file missing", func(t *testing.T) {
+		origAddr, origTokenPath := *adminTCPListenAddr, *adminTCPAuthTokenPath
+		defer func() {
+			*adminTCPListenAddr = origAddr
+			*adminTCPAuthTokenPath = origTokenPath
+		}()
+
+		*adminTCPListenAddr = "127.0.0.1:0"
+		*adminTCPAuthTokenPath = filepath.Join(t.TempDir(), "does-not-exist")
+
+		socketPath := filepath.Join(t.TempDir(), "admin.sock")
+
+		if _, err := adminServiceRunnables(logger, socketPath, injectC, signedVAAC, gst); err == nil {
+			t.Fatal("expected error reading the missing auth token file, got nil")
+		}
+	})
+}
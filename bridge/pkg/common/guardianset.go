@@ -0,0 +1,17 @@
+package common
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// MaxGuardianCount is the maximum number of guardians that can be part of a guardian set.
+// Guardian set updates that would exceed this are rejected before they are turned into a VAA.
+const MaxGuardianCount = 19
+
+// GuardianSet is the current view of the active guardian set, as tracked from observed
+// GuardianSetUpdate VAAs. It is shared (read-only) with the admin service so it can verify
+// offline-collected signatures without talking to the rest of the node.
+type GuardianSet struct {
+	Keys  []ethcommon.Address
+	Index uint32
+}
@@ -0,0 +1,33 @@
+package supervisor
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+// Runnable is a supervised, long-running task. It must block until ctx is canceled or it
+// encounters an unrecoverable error.
+type Runnable func(ctx context.Context) error
+
+// GRPCServer returns a Runnable that serves srv on l until ctx is canceled, at which point it
+// gracefully stops the server. logAccess is not read: GRPCServer does no per-call access logging
+// of its own, whatever its value. The admin service passes false; its handlers log admin RPCs
+// individually.
+func GRPCServer(srv *grpc.Server, l net.Listener, logAccess bool) Runnable {
+	return func(ctx context.Context) error {
+		errC := make(chan error, 1)
+		go func() {
+			errC <- srv.Serve(l)
+		}()
+
+		select {
+		case <-ctx.Done():
+			srv.GracefulStop()
+			return ctx.Err()
+		case err := <-errC:
+			return err
+		}
+	}
+}
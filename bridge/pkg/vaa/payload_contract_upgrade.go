@@ -0,0 +1,28 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// ActionContractUpgrade is the governance action byte for a contract upgrade payload.
+const ActionContractUpgrade = uint8(1)
+
+// BodyContractUpgrade is the payload of a governance VAA that points a chain's Wormhole contract
+// at a new implementation.
+type BodyContractUpgrade struct {
+	ChainID     ChainID
+	NewContract Address
+	Nonce       uint32
+}
+
+// Serialize returns the deterministic wire encoding of the contract upgrade: action byte, chain
+// ID, the 32-byte new implementation address, and the nonce.
+func (b BodyContractUpgrade) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(ActionContractUpgrade)
+	_ = binary.Write(buf, binary.BigEndian, uint16(b.ChainID))
+	buf.Write(b.NewContract[:])
+	_ = binary.Write(buf, binary.BigEndian, b.Nonce)
+	return buf.Bytes()
+}
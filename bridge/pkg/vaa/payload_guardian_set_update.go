@@ -0,0 +1,30 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// ActionGuardianSetUpdate is the governance action byte for a guardian set update payload.
+const ActionGuardianSetUpdate = uint8(2)
+
+// BodyGuardianSetUpdate is the payload of a governance VAA that replaces the active guardian set.
+type BodyGuardianSetUpdate struct {
+	Keys     []ethcommon.Address
+	NewIndex uint32
+}
+
+// Serialize returns the deterministic wire encoding of the guardian set update: action byte,
+// new index, guardian count, followed by each guardian's 20-byte address.
+func (b BodyGuardianSetUpdate) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(ActionGuardianSetUpdate)
+	_ = binary.Write(buf, binary.BigEndian, b.NewIndex)
+	buf.WriteByte(uint8(len(b.Keys)))
+	for _, k := range b.Keys {
+		buf.Write(k.Bytes())
+	}
+	return buf.Bytes()
+}
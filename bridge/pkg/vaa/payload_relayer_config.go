@@ -0,0 +1,53 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// ActionRelayerConfig is the governance action byte for a generic relayer config payload.
+const ActionRelayerConfig = uint8(3)
+
+// MaxRelayerChainConfigs is the maximum number of chain configs a single BodyRelayerConfig can
+// carry - the serialized count is a single byte, so more would silently wrap.
+const MaxRelayerChainConfigs = 255
+
+// RelayerChainConfig describes the generic relayer configuration for a single chain.
+type RelayerChainConfig struct {
+	ChainID        ChainID
+	RelayerAddress ethcommon.Address
+	GasLimit       uint32
+	DeliveryFee    uint64
+	Enabled        bool
+}
+
+// BodyRelayerConfig is the payload of a governance VAA that (re)configures the off-chain generic
+// relayer network: per-chain relayer addresses, gas limits, delivery fees, and enable/disable.
+type BodyRelayerConfig struct {
+	Nonce        uint32
+	ChainConfigs []RelayerChainConfig
+}
+
+// Serialize returns the deterministic wire encoding of the relayer config: action byte, nonce,
+// chain config count, followed by each chain's {chainID, relayer address, gas limit, delivery
+// fee, enabled} tuple.
+func (b BodyRelayerConfig) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(ActionRelayerConfig)
+	_ = binary.Write(buf, binary.BigEndian, b.Nonce)
+	buf.WriteByte(uint8(len(b.ChainConfigs)))
+	for _, c := range b.ChainConfigs {
+		_ = binary.Write(buf, binary.BigEndian, uint16(c.ChainID))
+		buf.Write(c.RelayerAddress.Bytes())
+		_ = binary.Write(buf, binary.BigEndian, c.GasLimit)
+		_ = binary.Write(buf, binary.BigEndian, c.DeliveryFee)
+		if c.Enabled {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,51 @@
+package vaa
+
+import (
+	"time"
+)
+
+// SupportedVAAVersion is the only VAA wire format version understood by this node.
+const SupportedVAAVersion = uint8(1)
+
+// ChainID is a Wormhole chain identifier, as used in governance VAA payloads.
+type ChainID uint16
+
+const (
+	ChainIDSolana   ChainID = 1
+	ChainIDEthereum ChainID = 2
+	ChainIDTerra    ChainID = 3
+)
+
+// Address is a canonical 32-byte Wormhole address. EVM addresses are left-padded with zeroes;
+// addresses that are natively 32 bytes (Solana, Terra, ...) are stored as-is.
+type Address [32]byte
+
+// VAAPayload is implemented by every governance payload body (BodyGuardianSetUpdate,
+// BodyContractUpgrade, BodyRelayerConfig, ...). Serialize returns the payload's canonical,
+// deterministic wire encoding.
+type VAAPayload interface {
+	Serialize() []byte
+}
+
+// Signature is a single guardian's signature over a VAA's digest.
+type Signature struct {
+	Index     uint8
+	Signature [65]byte
+}
+
+// VAA is a Verifiable Action Approval: a payload attested to by a quorum of the guardian set.
+type VAA struct {
+	Version          uint8
+	GuardianSetIndex uint32
+	Signatures       []*Signature
+	Timestamp        time.Time
+	Payload          VAAPayload
+}
+
+// rawPayload wraps an already-serialized payload so a VAA parsed off the wire (where the
+// concrete payload type isn't known) can still be re-serialized unchanged.
+type rawPayload []byte
+
+func (r rawPayload) Serialize() []byte {
+	return r
+}
@@ -0,0 +1,118 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// body returns the canonical, unsigned encoding of the VAA: everything that is hashed to produce
+// the signing digest, and everything after the signature list in the full wire format.
+func (v *VAA) body() []byte {
+	buf := new(bytes.Buffer)
+	_ = binary.Write(buf, binary.BigEndian, v.GuardianSetIndex)
+	_ = binary.Write(buf, binary.BigEndian, uint32(v.Timestamp.Unix()))
+	buf.Write(v.Payload.Serialize())
+	return buf.Bytes()
+}
+
+// SigningMsg returns the digest that guardians sign over: the double Keccak256 hash of the VAA's
+// unsigned body, matching the on-chain verification scheme.
+func (v *VAA) SigningMsg() (ethcommon.Hash, error) {
+	if v.Payload == nil {
+		return ethcommon.Hash{}, fmt.Errorf("vaa has no payload")
+	}
+
+	inner := ethcrypto.Keccak256Hash(v.body())
+	return ethcrypto.Keccak256Hash(inner.Bytes()), nil
+}
+
+// Marshal serializes the full VAA, including its current signatures, to the canonical wire
+// format used both for guardian-to-guardian gossip and for the offline PrepareUnsignedVAA /
+// SubmitSignedVAA round trip (where it is marshaled with zero signatures).
+func (v *VAA) Marshal() ([]byte, error) {
+	if v.Payload == nil {
+		return nil, fmt.Errorf("vaa has no payload")
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte(v.Version)
+
+	if len(v.Signatures) > 255 {
+		return nil, fmt.Errorf("too many signatures: %d", len(v.Signatures))
+	}
+	buf.WriteByte(uint8(len(v.Signatures)))
+	for _, sig := range v.Signatures {
+		buf.WriteByte(sig.Index)
+		buf.Write(sig.Signature[:])
+	}
+
+	buf.Write(v.body())
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the wire format produced by Marshal. The concrete payload type is not known
+// at this layer, so Payload is populated with its raw serialized bytes; callers that need the
+// structured form must decode it separately.
+func Unmarshal(data []byte) (*VAA, error) {
+	if len(data) < 1+1+4+4 {
+		return nil, fmt.Errorf("vaa too short: %d bytes", len(data))
+	}
+
+	v := &VAA{}
+	r := bytes.NewReader(data)
+
+	version, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version: %w", err)
+	}
+	v.Version = version
+
+	numSigs, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature count: %w", err)
+	}
+
+	for i := 0; i < int(numSigs); i++ {
+		idx, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signature %d index: %w", i, err)
+		}
+
+		var sig [65]byte
+		if _, err := r.Read(sig[:]); err != nil {
+			return nil, fmt.Errorf("failed to read signature %d: %w", i, err)
+		}
+
+		v.Signatures = append(v.Signatures, &Signature{Index: idx, Signature: sig})
+	}
+
+	if err := binary.Read(r, binary.BigEndian, &v.GuardianSetIndex); err != nil {
+		return nil, fmt.Errorf("failed to read guardian set index: %w", err)
+	}
+
+	var ts uint32
+	if err := binary.Read(r, binary.BigEndian, &ts); err != nil {
+		return nil, fmt.Errorf("failed to read timestamp: %w", err)
+	}
+	v.Timestamp = time.Unix(int64(ts), 0)
+
+	payload := make([]byte, r.Len())
+	if _, err := r.Read(payload); err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+	v.Payload = rawPayload(payload)
+
+	return v, nil
+}
+
+// CalculateQuorum returns the number of guardian signatures required for a VAA to be considered
+// valid for a guardian set of the given size: floor(numGuardians*2/3) + 1.
+func CalculateQuorum(numGuardians int) int {
+	return (numGuardians*2)/3 + 1
+}